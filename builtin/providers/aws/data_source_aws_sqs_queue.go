@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+func dataSourceAwsSqsQueue() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSqsQueueRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delay_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_message_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"message_retention_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"receive_wait_time_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"visibility_timeout_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"redrive_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fifo_queue": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"content_based_deduplication": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"deduplication_scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fifo_throughput_limit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_master_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_data_key_reuse_period_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sqs_managed_sse_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
+	sqsconn := meta.(*AWSClient).sqsconn
+
+	name := d.Get("name").(string)
+
+	urlOutput, err := sqsconn.GetQueueUrl(&sqs.GetQueueUrlInput{
+		QueueName: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("Error getting queue URL: %s", err)
+	}
+
+	queueUrl := *urlOutput.QueueUrl
+	d.SetId(queueUrl)
+	d.Set("url", queueUrl)
+
+	attributeOutput, err := sqsconn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(queueUrl),
+		AttributeNames: []*string{aws.String("All")},
+	})
+	if err != nil {
+		return fmt.Errorf("Error getting queue attributes: %s", err)
+	}
+
+	if attributeOutput.Attributes != nil {
+		attrmap := attributeOutput.Attributes
+		dataSourceSchema := dataSourceAwsSqsQueue().Schema
+		for iKey, oKey := range AttributeMap {
+			s, ok := dataSourceSchema[iKey]
+			if !ok || attrmap[oKey] == nil {
+				continue
+			}
+			switch s.Type {
+			case schema.TypeInt:
+				value, err := strconv.Atoi(*attrmap[oKey])
+				if err != nil {
+					return err
+				}
+				d.Set(iKey, value)
+			case schema.TypeBool:
+				value, err := strconv.ParseBool(*attrmap[oKey])
+				if err != nil {
+					return err
+				}
+				d.Set(iKey, value)
+			default:
+				d.Set(iKey, *attrmap[oKey])
+			}
+		}
+	}
+
+	return nil
+}
+
+func dataSourceAwsSqsQueueDeadLetterSourceQueues() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSqsQueueDeadLetterSourceQueuesRead,
+
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"queue_urls": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsSqsQueueDeadLetterSourceQueuesRead(d *schema.ResourceData, meta interface{}) error {
+	sqsconn := meta.(*AWSClient).sqsconn
+
+	queueUrl := d.Get("queue_url").(string)
+
+	output, err := sqsconn.ListDeadLetterSourceQueues(&sqs.ListDeadLetterSourceQueuesInput{
+		QueueUrl: aws.String(queueUrl),
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing SQS dead-letter source queues: %s", err)
+	}
+
+	urls := make([]string, 0, len(output.QueueUrls))
+	for _, u := range output.QueueUrls {
+		urls = append(urls, *u)
+	}
+
+	d.SetId(queueUrl)
+	d.Set("queue_urls", urls)
+
+	return nil
+}
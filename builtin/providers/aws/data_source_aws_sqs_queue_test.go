@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAWSSQSQueue_basic(t *testing.T) {
+	queueName := fmt.Sprintf("tf-acc-test-ds-queue-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSSQSQueueConfig(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.queue"),
+					resource.TestCheckResourceAttrPair("data.aws_sqs_queue.queue", "url", "aws_sqs_queue.queue", "id"),
+					resource.TestCheckResourceAttrPair("data.aws_sqs_queue.queue", "arn", "aws_sqs_queue.queue", "arn"),
+					resource.TestCheckResourceAttr("data.aws_sqs_queue.queue", "fifo_queue", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSSQSQueueDeadLetterSourceQueues_basic(t *testing.T) {
+	sourceQueueName := fmt.Sprintf("tf-acc-test-ds-dlq-source-%s", acctest.RandString(10))
+	dlqName := fmt.Sprintf("tf-acc-test-ds-dlq-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSSQSQueueDeadLetterSourceQueuesConfig(sourceQueueName, dlqName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.source"),
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.dlq"),
+					resource.TestCheckResourceAttr("data.aws_sqs_queue_dead_letter_source_queues.dlq", "queue_urls.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.aws_sqs_queue_dead_letter_source_queues.dlq", "queue_urls.0",
+						"aws_sqs_queue.source", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSSQSQueueConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name = "%s"
+}
+
+data "aws_sqs_queue" "queue" {
+  name = aws_sqs_queue.queue.name
+}
+`, name)
+}
+
+func testAccDataSourceAWSSQSQueueDeadLetterSourceQueuesConfig(sourceName, dlqName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "dlq" {
+  name = "%s"
+}
+
+resource "aws_sqs_queue" "source" {
+  name = "%s"
+
+  redrive_policy_config {
+    dead_letter_target_arn = aws_sqs_queue.dlq.arn
+    max_receive_count      = 3
+  }
+}
+
+data "aws_sqs_queue_dead_letter_source_queues" "dlq" {
+  queue_url = aws_sqs_queue.dlq.id
+
+  depends_on = [aws_sqs_queue.source]
+}
+`, dlqName, sourceName)
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/url"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 
+	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,14 +18,29 @@ import (
 )
 
 var AttributeMap = map[string]string{
-	"delay_seconds":              "DelaySeconds",
-	"max_message_size":           "MaximumMessageSize",
-	"message_retention_seconds":  "MessageRetentionPeriod",
-	"receive_wait_time_seconds":  "ReceiveMessageWaitTimeSeconds",
-	"visibility_timeout_seconds": "VisibilityTimeout",
-	"policy":                     "Policy",
-	"redrive_policy":             "RedrivePolicy",
-	"arn":                        "QueueArn",
+	"delay_seconds":                     "DelaySeconds",
+	"max_message_size":                  "MaximumMessageSize",
+	"message_retention_seconds":         "MessageRetentionPeriod",
+	"receive_wait_time_seconds":         "ReceiveMessageWaitTimeSeconds",
+	"visibility_timeout_seconds":        "VisibilityTimeout",
+	"policy":                            "Policy",
+	"redrive_policy":                    "RedrivePolicy",
+	"arn":                               "QueueArn",
+	"fifo_queue":                        "FifoQueue",
+	"content_based_deduplication":       "ContentBasedDeduplication",
+	"deduplication_scope":               "DeduplicationScope",
+	"fifo_throughput_limit":             "FifoThroughputLimit",
+	"kms_master_key_id":                 "KmsMasterKeyId",
+	"kms_data_key_reuse_period_seconds": "KmsDataKeyReusePeriodSeconds",
+	"sqs_managed_sse_enabled":           "SqsManagedSseEnabled",
+}
+
+// fifoOnlyAttributes are attributes that SQS rejects on a standard (non-FIFO)
+// queue.
+var fifoOnlyAttributes = []string{
+	"content_based_deduplication",
+	"deduplication_scope",
+	"fifo_throughput_limit",
 }
 
 // A number of these are marked as computed because if you don't
@@ -38,6 +55,7 @@ func resourceAwsSqsQueue() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceAwsSqsQueueCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -80,24 +98,365 @@ func resourceAwsSqsQueue() *schema.Resource {
 			"redrive_policy": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validateJsonString,
 				StateFunc: func(v interface{}) string {
 					json, _ := normalizeJsonString(v)
 					return json
 				},
+				// Once redrive_policy_config is adopted, the raw JSON form
+				// is superseded; suppress its diff so a config that omits
+				// redrive_policy doesn't fight the value Read populates
+				// from the live RedrivePolicy attribute.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					_, ok := d.GetOk("redrive_policy_config")
+					return ok
+				},
 			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"fifo_queue": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"content_based_deduplication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deduplication_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateSqsQueueDeduplicationScope,
+			},
+			"fifo_throughput_limit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateSqsQueueFifoThroughputLimit,
+			},
+			"kms_master_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"kms_data_key_reuse_period_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
+			},
+			"sqs_managed_sse_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// redrive_policy_config is a typed alternative to the raw JSON
+			// redrive_policy attribute above; if both are set, this block
+			// takes precedence. Named "_config" rather than "redrive_policy"
+			// because that name is already taken by the JSON-string field.
+			"redrive_policy_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dead_letter_target_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSqsQueueArn,
+						},
+						"max_receive_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateSqsQueueMaxReceiveCount,
+						},
+					},
+				},
+			},
+			"redrive_allow_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"redrive_permission": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSqsQueueRedrivePermission,
+						},
+						"source_queue_arns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateSqsQueueArn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^arn:[\w-]+:sqs:[a-z0-9-]+:\d{12}:[a-zA-Z0-9_-]+(\.fifo)?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q is not a valid SQS queue ARN: %q", k, value))
+	}
+	return
+}
+
+func validateSqsQueueMaxReceiveCount(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 1 || value > 1000 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 1000, got: %d", k, value))
+	}
+	return
+}
+
+func validateSqsQueueRedrivePermission(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "allowAll" && value != "denyAll" && value != "byQueue" {
+		errors = append(errors, fmt.Errorf("%q must be one of %q, %q, %q, got: %q", k, "allowAll", "denyAll", "byQueue", value))
+	}
+	return
+}
+
+func validateSqsQueueDeduplicationScope(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "messageGroup" && value != "queue" {
+		errors = append(errors, fmt.Errorf("%q must be either %q or %q, got: %q", k, "messageGroup", "queue", value))
+	}
+	return
+}
+
+func validateSqsQueueFifoThroughputLimit(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "perQueue" && value != "perMessageGroupId" {
+		errors = append(errors, fmt.Errorf("%q must be either %q or %q, got: %q", k, "perQueue", "perMessageGroupId", value))
+	}
+	return
+}
+
+// sqsRedrivePolicyAttributes marshals the redrive_policy_config and
+// redrive_allow_policy blocks into the RedrivePolicy/RedriveAllowPolicy SQS
+// attributes. When redrive_policy_config is set it takes precedence over the
+// raw JSON redrive_policy attribute.
+func sqsRedrivePolicyAttributes(d *schema.ResourceData) (map[string]*string, error) {
+	attrs := make(map[string]*string)
+
+	if v, ok := d.GetOk("redrive_policy_config"); ok {
+		config := v.([]interface{})
+		if len(config) > 0 && config[0] != nil {
+			m := config[0].(map[string]interface{})
+			policy := map[string]interface{}{
+				"deadLetterTargetArn": m["dead_letter_target_arn"].(string),
+				"maxReceiveCount":     m["max_receive_count"].(int),
+			}
+			b, err := json.Marshal(policy)
+			if err != nil {
+				return nil, fmt.Errorf("Error marshaling redrive_policy_config: %s", err)
+			}
+			attrs["RedrivePolicy"] = aws.String(string(b))
+		}
+	}
+
+	if v, ok := d.GetOk("redrive_allow_policy"); ok {
+		config := v.([]interface{})
+		if len(config) > 0 && config[0] != nil {
+			m := config[0].(map[string]interface{})
+			policy := map[string]interface{}{
+				"redrivePermission": m["redrive_permission"].(string),
+			}
+			if arns, ok := m["source_queue_arns"].([]interface{}); ok && len(arns) > 0 {
+				sourceArns := make([]string, len(arns))
+				for i, a := range arns {
+					sourceArns[i] = a.(string)
+				}
+				policy["sourceQueueArns"] = sourceArns
+			}
+			b, err := json.Marshal(policy)
+			if err != nil {
+				return nil, fmt.Errorf("Error marshaling redrive_allow_policy: %s", err)
+			}
+			attrs["RedriveAllowPolicy"] = aws.String(string(b))
+		}
+	}
+
+	return attrs, nil
+}
+
+// sqsRedrivePolicyUpdateAttributes is the Update counterpart of
+// sqsRedrivePolicyAttributes: it only touches RedrivePolicy/RedriveAllowPolicy
+// when the corresponding block actually changed, and explicitly clears the
+// attribute (empty string) when the block is removed from config, mirroring
+// how the flat AttributeMap loop clears attributes on removal.
+func sqsRedrivePolicyUpdateAttributes(d *schema.ResourceData) (map[string]*string, error) {
+	attrs := make(map[string]*string)
+
+	if d.HasChange("redrive_policy_config") {
+		config := d.Get("redrive_policy_config").([]interface{})
+		if len(config) > 0 && config[0] != nil {
+			m := config[0].(map[string]interface{})
+			policy := map[string]interface{}{
+				"deadLetterTargetArn": m["dead_letter_target_arn"].(string),
+				"maxReceiveCount":     m["max_receive_count"].(int),
+			}
+			b, err := json.Marshal(policy)
+			if err != nil {
+				return nil, fmt.Errorf("Error marshaling redrive_policy_config: %s", err)
+			}
+			attrs["RedrivePolicy"] = aws.String(string(b))
+		} else {
+			attrs["RedrivePolicy"] = aws.String("")
+		}
+	}
+
+	if d.HasChange("redrive_allow_policy") {
+		config := d.Get("redrive_allow_policy").([]interface{})
+		if len(config) > 0 && config[0] != nil {
+			m := config[0].(map[string]interface{})
+			policy := map[string]interface{}{
+				"redrivePermission": m["redrive_permission"].(string),
+			}
+			if arns, ok := m["source_queue_arns"].([]interface{}); ok && len(arns) > 0 {
+				sourceArns := make([]string, len(arns))
+				for i, a := range arns {
+					sourceArns[i] = a.(string)
+				}
+				policy["sourceQueueArns"] = sourceArns
+			}
+			b, err := json.Marshal(policy)
+			if err != nil {
+				return nil, fmt.Errorf("Error marshaling redrive_allow_policy: %s", err)
+			}
+			attrs["RedriveAllowPolicy"] = aws.String(string(b))
+		} else {
+			attrs["RedriveAllowPolicy"] = aws.String("")
+		}
+	}
+
+	return attrs, nil
+}
+
+// setSqsRedrivePolicyConfig parses the live RedrivePolicy attribute back into
+// the typed redrive_policy_config block so drift on the DLQ target/max
+// receive count is visible on refresh.
+func setSqsRedrivePolicyConfig(d *schema.ResourceData, raw *string) error {
+	if raw == nil || *raw == "" {
+		d.Set("redrive_policy_config", []interface{}{})
+		return nil
+	}
+
+	var policy struct {
+		DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+		MaxReceiveCount     int    `json:"maxReceiveCount"`
+	}
+	if err := json.Unmarshal([]byte(*raw), &policy); err != nil {
+		return fmt.Errorf("Error parsing RedrivePolicy: %s", err)
+	}
+
+	d.Set("redrive_policy_config", []interface{}{
+		map[string]interface{}{
+			"dead_letter_target_arn": policy.DeadLetterTargetArn,
+			"max_receive_count":      policy.MaxReceiveCount,
+		},
+	})
+	return nil
+}
+
+// setSqsRedriveAllowPolicy parses the live RedriveAllowPolicy attribute back
+// into the typed redrive_allow_policy block.
+func setSqsRedriveAllowPolicy(d *schema.ResourceData, raw *string) error {
+	if raw == nil || *raw == "" {
+		d.Set("redrive_allow_policy", []interface{}{})
+		return nil
+	}
+
+	var policy struct {
+		RedrivePermission string   `json:"redrivePermission"`
+		SourceQueueArns   []string `json:"sourceQueueArns"`
+	}
+	if err := json.Unmarshal([]byte(*raw), &policy); err != nil {
+		return fmt.Errorf("Error parsing RedriveAllowPolicy: %s", err)
+	}
+
+	sourceArns := make([]interface{}, len(policy.SourceQueueArns))
+	for i, arn := range policy.SourceQueueArns {
+		sourceArns[i] = arn
+	}
+
+	d.Set("redrive_allow_policy", []interface{}{
+		map[string]interface{}{
+			"redrive_permission": policy.RedrivePermission,
+			"source_queue_arns":  sourceArns,
 		},
+	})
+	return nil
+}
+
+// resourceAwsSqsQueueCustomizeDiff surfaces plan-time errors for
+// configuration that can't be validated field-by-field with ValidateFunc.
+func resourceAwsSqsQueueCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return validateSqsRedrivePolicyRegionAccount(d, meta)
+}
+
+// validateSqsRedrivePolicyRegionAccount rejects a dead_letter_target_arn
+// whose region or account doesn't match the queue being created. It runs as
+// part of CustomizeDiff so the error surfaces at `terraform plan`, not just
+// on apply.
+func validateSqsRedrivePolicyRegionAccount(d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("redrive_policy_config")
+	if !ok {
+		return nil
 	}
+	config := v.([]interface{})
+	if len(config) == 0 || config[0] == nil {
+		return nil
+	}
+
+	client := meta.(*AWSClient)
+	targetArn := config[0].(map[string]interface{})["dead_letter_target_arn"].(string)
+
+	parts := strings.SplitN(targetArn, ":", 6)
+	if len(parts) < 6 {
+		return fmt.Errorf("Invalid dead_letter_target_arn: %q", targetArn)
+	}
+	region, account := parts[3], parts[4]
+
+	if region != client.region {
+		return fmt.Errorf("dead_letter_target_arn region (%s) does not match the queue's region (%s)", region, client.region)
+	}
+	if account != client.accountid {
+		return fmt.Errorf("dead_letter_target_arn account (%s) does not match the queue's account (%s)", account, client.accountid)
+	}
+
+	return nil
 }
 
 func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	sqsconn := meta.(*AWSClient).sqsconn
 
 	name := d.Get("name").(string)
+	fifoQueue := d.Get("fifo_queue").(bool)
+
+	if err := validateSqsQueueName(name, fifoQueue); err != nil {
+		return err
+	}
+
+	if err := validateSqsFifoOnlyAttributes(d, fifoQueue); err != nil {
+		return err
+	}
+
+	if err := validateSqsSseAttributes(d); err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] SQS queue create: %s", name)
 
@@ -112,9 +471,12 @@ func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	for k, s := range resource.Schema {
 		if attrKey, ok := AttributeMap[k]; ok {
 			if value, ok := d.GetOk(k); ok {
-				if s.Type == schema.TypeInt {
+				switch s.Type {
+				case schema.TypeInt:
 					attributes[attrKey] = aws.String(strconv.Itoa(value.(int)))
-				} else {
+				case schema.TypeBool:
+					attributes[attrKey] = aws.String(strconv.FormatBool(value.(bool)))
+				default:
 					attributes[attrKey] = aws.String(value.(string))
 				}
 			}
@@ -122,6 +484,14 @@ func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	redriveAttrs, err := sqsRedrivePolicyAttributes(d)
+	if err != nil {
+		return err
+	}
+	for k, v := range redriveAttrs {
+		attributes[k] = v
+	}
+
 	if len(attributes) > 0 {
 		req.Attributes = attributes
 	}
@@ -138,6 +508,15 @@ func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsSqsQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 	sqsconn := meta.(*AWSClient).sqsconn
+
+	if err := validateSqsFifoOnlyAttributes(d, d.Get("fifo_queue").(bool)); err != nil {
+		return err
+	}
+
+	if err := validateSqsSseAttributes(d); err != nil {
+		return err
+	}
+
 	attributes := make(map[string]*string)
 
 	resource := *resourceAwsSqsQueue()
@@ -147,15 +526,26 @@ func resourceAwsSqsQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 			if d.HasChange(k) {
 				log.Printf("[DEBUG] Updating %s", attrKey)
 				_, n := d.GetChange(k)
-				if s.Type == schema.TypeInt {
+				switch s.Type {
+				case schema.TypeInt:
 					attributes[attrKey] = aws.String(strconv.Itoa(n.(int)))
-				} else {
+				case schema.TypeBool:
+					attributes[attrKey] = aws.String(strconv.FormatBool(n.(bool)))
+				default:
 					attributes[attrKey] = aws.String(n.(string))
 				}
 			}
 		}
 	}
 
+	redriveAttrs, err := sqsRedrivePolicyUpdateAttributes(d)
+	if err != nil {
+		return err
+	}
+	for k, v := range redriveAttrs {
+		attributes[k] = v
+	}
+
 	if len(attributes) > 0 {
 		req := &sqs.SetQueueAttributesInput{
 			QueueUrl:   aws.String(d.Id()),
@@ -166,9 +556,57 @@ func resourceAwsSqsQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("tags") {
+		if err := resourceAwsSqsQueueUpdateTags(sqsconn, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsSqsQueueRead(d, meta)
 }
 
+func resourceAwsSqsQueueUpdateTags(sqsconn *sqs.SQS, d *schema.ResourceData) error {
+	o, n := d.GetChange("tags")
+	oldTags := o.(map[string]interface{})
+	newTags := n.(map[string]interface{})
+
+	var removeKeys []*string
+	for k := range oldTags {
+		if _, ok := newTags[k]; !ok {
+			removeKeys = append(removeKeys, aws.String(k))
+		}
+	}
+	if len(removeKeys) > 0 {
+		log.Printf("[DEBUG] Removing SQS queue tags: %#v", removeKeys)
+		_, err := sqsconn.UntagQueue(&sqs.UntagQueueInput{
+			QueueUrl: aws.String(d.Id()),
+			TagKeys:  removeKeys,
+		})
+		if err != nil {
+			return fmt.Errorf("Error removing SQS queue tags: %s", err)
+		}
+	}
+
+	addTags := make(map[string]*string)
+	for k, v := range newTags {
+		if old, ok := oldTags[k]; !ok || old != v {
+			addTags[k] = aws.String(v.(string))
+		}
+	}
+	if len(addTags) > 0 {
+		log.Printf("[DEBUG] Adding SQS queue tags: %#v", addTags)
+		_, err := sqsconn.TagQueue(&sqs.TagQueueInput{
+			QueueUrl: aws.String(d.Id()),
+			Tags:     addTags,
+		})
+		if err != nil {
+			return fmt.Errorf("Error adding SQS queue tags: %s", err)
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
 	sqsconn := meta.(*AWSClient).sqsconn
 
@@ -201,21 +639,54 @@ func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
 		// iKey = internal struct key, oKey = AWS Attribute Map key
 		for iKey, oKey := range AttributeMap {
 			if attrmap[oKey] != nil {
-				if resource.Schema[iKey].Type == schema.TypeInt {
+				switch resource.Schema[iKey].Type {
+				case schema.TypeInt:
 					value, err := strconv.Atoi(*attrmap[oKey])
 					if err != nil {
 						return err
 					}
 					d.Set(iKey, value)
 					log.Printf("[DEBUG] Reading %s => %s -> %d", iKey, oKey, value)
-				} else {
+				case schema.TypeBool:
+					value, err := strconv.ParseBool(*attrmap[oKey])
+					if err != nil {
+						return err
+					}
+					d.Set(iKey, value)
+					log.Printf("[DEBUG] Reading %s => %s -> %t", iKey, oKey, value)
+				default:
 					log.Printf("[DEBUG] Reading %s => %s -> %s", iKey, oKey, *attrmap[oKey])
 					d.Set(iKey, *attrmap[oKey])
 				}
 			}
 		}
+
+		// Only fill the typed block when it is in use, so a config that sets
+		// just the raw JSON redrive_policy doesn't see the block appear and
+		// then plan its removal (which would clear RedrivePolicy).
+		if _, ok := d.GetOk("redrive_policy_config"); ok {
+			if err := setSqsRedrivePolicyConfig(d, attrmap["RedrivePolicy"]); err != nil {
+				return err
+			}
+		}
+		if err := setSqsRedriveAllowPolicy(d, attrmap["RedriveAllowPolicy"]); err != nil {
+			return err
+		}
 	}
 
+	tagsOutput, err := sqsconn.ListQueueTags(&sqs.ListQueueTagsInput{
+		QueueUrl: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing SQS queue tags: %s", err)
+	}
+
+	tags := make(map[string]string)
+	for k, v := range tagsOutput.Tags {
+		tags[k] = *v
+	}
+	d.Set("tags", tags)
+
 	return nil
 }
 
@@ -246,3 +717,40 @@ func extractNameFromSqsQueueUrl(queue string) (string, error) {
 	return segments[2], nil
 
 }
+
+// validateSqsQueueName enforces the `.fifo` suffix required by SQS for
+// FIFO queues, and rejects it on standard queues.
+func validateSqsQueueName(name string, fifoQueue bool) error {
+	if fifoQueue && !strings.HasSuffix(name, ".fifo") {
+		return fmt.Errorf("Invalid SQS queue name: %q. FIFO queue names must end in \".fifo\"", name)
+	}
+	if !fifoQueue && strings.HasSuffix(name, ".fifo") {
+		return fmt.Errorf("Invalid SQS queue name: %q. Queue names ending in \".fifo\" require fifo_queue to be set to true", name)
+	}
+	return nil
+}
+
+// validateSqsSseAttributes rejects the combination of a customer-managed KMS
+// key and SQS-managed SSE, which SQS treats as mutually exclusive.
+func validateSqsSseAttributes(d *schema.ResourceData) error {
+	_, hasKmsKey := d.GetOk("kms_master_key_id")
+	sqsManagedSse := d.Get("sqs_managed_sse_enabled").(bool)
+	if hasKmsKey && sqsManagedSse {
+		return fmt.Errorf("conflicting SSE configuration: kms_master_key_id and sqs_managed_sse_enabled cannot both be set")
+	}
+	return nil
+}
+
+// validateSqsFifoOnlyAttributes rejects FIFO-only attributes when set on a
+// standard queue.
+func validateSqsFifoOnlyAttributes(d *schema.ResourceData, fifoQueue bool) error {
+	if fifoQueue {
+		return nil
+	}
+	for _, k := range fifoOnlyAttributes {
+		if _, ok := d.GetOk(k); ok {
+			return fmt.Errorf("%q is only valid for FIFO queues (fifo_queue = true)", k)
+		}
+	}
+	return nil
+}
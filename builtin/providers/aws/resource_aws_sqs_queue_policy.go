@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+// resourceAwsSqsQueuePolicy manages an SQS queue's Policy attribute
+// independently of the queue resource, mirroring aws_s3_bucket_policy. This
+// avoids policy diffs racing with other queue attribute updates; the
+// `policy` attribute on aws_sqs_queue is kept for backward compatibility.
+func resourceAwsSqsQueuePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSqsQueuePolicyUpsert,
+		Read:   resourceAwsSqsQueuePolicyRead,
+		Update: resourceAwsSqsQueuePolicyUpsert,
+		Delete: resourceAwsSqsQueuePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsSqsQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
+	sqsconn := meta.(*AWSClient).sqsconn
+
+	queueUrl := d.Get("queue_url").(string)
+	policy := d.Get("policy").(string)
+
+	log.Printf("[DEBUG] SQS queue policy upsert: %s", queueUrl)
+
+	req := &sqs.SetQueueAttributesInput{
+		QueueUrl: aws.String(queueUrl),
+		Attributes: map[string]*string{
+			"Policy": aws.String(policy),
+		},
+	}
+	if _, err := sqsconn.SetQueueAttributes(req); err != nil {
+		return fmt.Errorf("Error updating SQS queue policy: %s", err)
+	}
+
+	d.SetId(queueUrl)
+
+	return resourceAwsSqsQueuePolicyRead(d, meta)
+}
+
+func resourceAwsSqsQueuePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	sqsconn := meta.(*AWSClient).sqsconn
+
+	attributeOutput, err := sqsconn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(d.Id()),
+		AttributeNames: []*string{aws.String("Policy")},
+	})
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if "AWS.SimpleQueueService.NonExistentQueue" == awsErr.Code() {
+				log.Printf("[DEBUG] SQS Queue (%s) not found", d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("queue_url", d.Id())
+
+	if attributeOutput.Attributes != nil {
+		if policy, ok := attributeOutput.Attributes["Policy"]; ok && policy != nil {
+			d.Set("policy", *policy)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsSqsQueuePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	sqsconn := meta.(*AWSClient).sqsconn
+
+	log.Printf("[DEBUG] SQS queue policy delete: %s", d.Id())
+
+	req := &sqs.SetQueueAttributesInput{
+		QueueUrl: aws.String(d.Id()),
+		Attributes: map[string]*string{
+			"Policy": aws.String(""),
+		},
+	}
+	if _, err := sqsconn.SetQueueAttributes(req); err != nil {
+		return fmt.Errorf("Error clearing SQS queue policy: %s", err)
+	}
+
+	return nil
+}
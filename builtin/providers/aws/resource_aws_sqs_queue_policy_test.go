@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSQSQueuePolicy_basic(t *testing.T) {
+	queueName := fmt.Sprintf("tf-acc-test-queue-policy-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueuePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSQueuePolicyConfig(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueuePolicyExists("aws_sqs_queue_policy.queue"),
+					resource.TestCheckResourceAttrSet("aws_sqs_queue_policy.queue", "policy"),
+				),
+			},
+			{
+				// Drift-detection round trip: a plain refresh against the
+				// same config should not produce a diff.
+				Config:   testAccAWSSQSQueuePolicyConfig(queueName),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSSQSQueueConfig(queueName),
+				Check:  testAccCheckAWSSQSQueuePolicyCleared("aws_sqs_queue.queue"),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSQSQueuePolicyCleared(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		out, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+			QueueUrl:       aws.String(rs.Primary.ID),
+			AttributeNames: []*string{aws.String("Policy")},
+		})
+		if err != nil {
+			return err
+		}
+
+		if out.Attributes["Policy"] != nil && *out.Attributes["Policy"] != "" {
+			return fmt.Errorf("SQS queue %s still has a policy set after aws_sqs_queue_policy was removed", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSQSQueuePolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SQS queue URL is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		out, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+			QueueUrl:       aws.String(rs.Primary.ID),
+			AttributeNames: []*string{aws.String("Policy")},
+		})
+		if err != nil {
+			return err
+		}
+
+		if out.Attributes["Policy"] == nil || *out.Attributes["Policy"] == "" {
+			return fmt.Errorf("SQS queue %s has no policy set", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSQSQueuePolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sqsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sqs_queue_policy" {
+			continue
+		}
+
+		out, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+			QueueUrl:       aws.String(rs.Primary.ID),
+			AttributeNames: []*string{aws.String("Policy")},
+		})
+		if err != nil {
+			// The queue itself is destroyed alongside the policy in this
+			// test fixture, so a NonExistentQueue error also proves the
+			// policy is gone.
+			continue
+		}
+
+		if out.Attributes["Policy"] != nil && *out.Attributes["Policy"] != "" {
+			return fmt.Errorf("SQS queue policy for %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSQSQueuePolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name = "%s"
+}
+
+resource "aws_sqs_queue_policy" "queue" {
+  queue_url = aws_sqs_queue.queue.id
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Id": "sqspolicy",
+  "Statement": [
+    {
+      "Sid": "AllowQueueOwner",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "sqs:SendMessage",
+      "Resource": "${aws_sqs_queue.queue.arn}"
+    }
+  ]
+}
+POLICY
+}
+`, name)
+}
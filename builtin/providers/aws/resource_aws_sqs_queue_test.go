@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSQSQueue_fifoQueue(t *testing.T) {
+	queueName := fmt.Sprintf("tf-acc-test-fifo-%s.fifo", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSFifoQueueConfig(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "fifo_queue", "true"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "content_based_deduplication", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSQSQueue_fifoQueueHighThroughput(t *testing.T) {
+	queueName := fmt.Sprintf("tf-acc-test-fifo-ht-%s.fifo", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSFifoQueueHighThroughputConfig(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "fifo_queue", "true"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "deduplication_scope", "messageGroup"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "fifo_throughput_limit", "perMessageGroupId"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSQSQueue_fifoQueueNameValidation(t *testing.T) {
+	queueName := fmt.Sprintf("tf-acc-test-not-fifo-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSQSFifoQueueConfig(queueName),
+				ExpectError: regexp.MustCompile(`FIFO queue names must end`),
+			},
+		},
+	})
+}
+
+func TestAccAWSSQSQueue_sseKeyRotation(t *testing.T) {
+	queueName := fmt.Sprintf("tf-acc-test-sse-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSQueueKmsConfig(queueName, "alias/aws/sqs"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "kms_master_key_id", "alias/aws/sqs"),
+				),
+			},
+			{
+				Config: testAccAWSSQSQueueKmsConfig(queueName, "alias/aws/sns"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "kms_master_key_id", "alias/aws/sns"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSQSQueue_sseToggle(t *testing.T) {
+	queueName := fmt.Sprintf("tf-acc-test-sse-toggle-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSQueueConfig(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "sqs_managed_sse_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAWSSQSQueueSqsSseConfig(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "sqs_managed_sse_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSSQSQueueConfig(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "sqs_managed_sse_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSQSQueue_sseMutualExclusivity(t *testing.T) {
+	queueName := fmt.Sprintf("tf-acc-test-sse-conflict-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSQSQueueConflictingSseConfig(queueName),
+				ExpectError: regexp.MustCompile(`conflicting SSE configuration`),
+			},
+		},
+	})
+}
+
+func TestAccAWSSQSQueue_tags(t *testing.T) {
+	queueName := fmt.Sprintf("tf-acc-test-tags-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSQueueTagsConfig1(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.Environment", "test"),
+				),
+			},
+			{
+				// Drift-detection round trip: a plain refresh against the
+				// same config should not produce a diff.
+				Config:   testAccAWSSQSQueueTagsConfig1(queueName),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSSQSQueueTagsConfig2(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.%", "2"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.Environment", "prod"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.Owner", "platform"),
+				),
+			},
+			{
+				Config: testAccAWSSQSQueueConfig(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists("aws_sqs_queue.queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSQSQueueExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SQS queue URL is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		_, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+			QueueUrl:       aws.String(rs.Primary.ID),
+			AttributeNames: []*string{aws.String("QueueArn")},
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSSQSQueueDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sqsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sqs_queue" {
+			continue
+		}
+
+		_, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+			QueueUrl:       aws.String(rs.Primary.ID),
+			AttributeNames: []*string{aws.String("QueueArn")},
+		})
+		if err == nil {
+			return fmt.Errorf("Queue %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSQSFifoQueueConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name                        = "%s"
+  fifo_queue                  = true
+  content_based_deduplication = true
+}
+`, name)
+}
+
+func testAccAWSSQSFifoQueueHighThroughputConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name                        = "%s"
+  fifo_queue                  = true
+  content_based_deduplication = true
+  deduplication_scope         = "messageGroup"
+  fifo_throughput_limit       = "perMessageGroupId"
+}
+`, name)
+}
+
+func testAccAWSSQSQueueConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name = "%s"
+}
+`, name)
+}
+
+func testAccAWSSQSQueueKmsConfig(name, kmsMasterKeyId string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name               = "%s"
+  kms_master_key_id  = "%s"
+}
+`, name, kmsMasterKeyId)
+}
+
+func testAccAWSSQSQueueSqsSseConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name                    = "%s"
+  sqs_managed_sse_enabled = true
+}
+`, name)
+}
+
+func testAccAWSSQSQueueConflictingSseConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name                    = "%s"
+  kms_master_key_id       = "alias/aws/sqs"
+  sqs_managed_sse_enabled = true
+}
+`, name)
+}
+
+func testAccAWSSQSQueueTagsConfig1(name string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name = "%s"
+
+  tags = {
+    Environment = "test"
+  }
+}
+`, name)
+}
+
+func testAccAWSSQSQueueTagsConfig2(name string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name = "%s"
+
+  tags = {
+    Environment = "prod"
+    Owner       = "platform"
+  }
+}
+`, name)
+}